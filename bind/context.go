@@ -0,0 +1,58 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import "go/types"
+
+// contextPkgPath is the import path of the standard library package
+// whose Context type gobind gives first-class treatment.
+const contextPkgPath = "context"
+
+// isContextParam reports whether typ is context.Context, the only type
+// gobind strips from the foreign-facing signature of a bound function,
+// method, or interface method.
+func isContextParam(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj != nil && obj.Name() == "Context" && obj.Pkg() != nil && obj.Pkg().Path() == contextPkgPath
+}
+
+// hasContextFirstParam reports whether sig's first parameter is
+// context.Context, i.e. whether the bound func, method, or interface
+// method is eligible for the context plumbing BoundParams describes.
+func hasContextFirstParam(sig *types.Signature) bool {
+	if sig.Params().Len() == 0 {
+		return false
+	}
+	return isContextParam(sig.Params().At(0).Type())
+}
+
+// BoundParams returns the parameters of sig as gobind exposes them on
+// the foreign side: with a leading context.Context, if any, dropped.
+// hasContext reports whether one was dropped. sig may be a function,
+// method, or interface method signature; BoundParams makes no
+// distinction between them.
+//
+// BoundParams only classifies the signature. Synthesizing a context on
+// entry (a cancellable Java overload backed by context.WithCancel, an
+// ObjC "cancel" selector, and propagating ctx.Done() across the
+// JNI/cgo boundary in both call directions) is not implemented here —
+// there is no Java/ObjC emitter or JNI/cgo trampoline in this tree to
+// hang that on.
+func BoundParams(sig *types.Signature) (params []*types.Var, hasContext bool) {
+	n := sig.Params().Len()
+	start := 0
+	if hasContextFirstParam(sig) {
+		hasContext = true
+		start = 1
+	}
+	for i := start; i < n; i++ {
+		params = append(params, sig.Params().At(i))
+	}
+	return params, hasContext
+}
@@ -0,0 +1,84 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+const testpkgPath = "testdata/testpkg/testpkg.go"
+
+func loadTestpkg(t *testing.T) *Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, testpkgPath, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("ParseFile(%s): %v", testpkgPath, err)
+	}
+	return LoadFile(fset, f, "testpkg")
+}
+
+func funcSignature(t *testing.T, pkg *Package, name string) *types.Signature {
+	t.Helper()
+	fn := pkg.Func(name)
+	if fn == nil {
+		t.Fatalf("%s not found in %s", name, testpkgPath)
+	}
+	return fn.Type().(*types.Signature)
+}
+
+func TestBoundParamsDropsLeadingContext(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	sig := funcSignature(t, pkg, "NewMyStruct")
+	params, hasContext := BoundParams(sig)
+	if !hasContext {
+		t.Fatalf("NewMyStruct: hasContext = false, want true")
+	}
+	if len(params) != 0 {
+		t.Fatalf("NewMyStruct: got %d bound params, want 0 (only the context param)", len(params))
+	}
+
+	sig = funcSignature(t, pkg, "CallWithContext")
+	params, hasContext = BoundParams(sig)
+	if !hasContext {
+		t.Fatalf("CallWithContext: hasContext = false, want true")
+	}
+	if len(params) != 1 || params[0].Name() != "r" {
+		t.Fatalf("CallWithContext: got params %v, want [r]", params)
+	}
+}
+
+func TestBoundParamsInterfaceMethod(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	// ContextReceiver.Greet(ctx context.Context, name string) is an
+	// interface method, not a top-level func; BoundParams must
+	// classify its leading context.Context the same way.
+	sig := funcSignature(t, pkg, "Greet")
+	params, hasContext := BoundParams(sig)
+	if !hasContext {
+		t.Fatalf("ContextReceiver.Greet: hasContext = false, want true")
+	}
+	if len(params) != 1 || params[0].Name() != "name" {
+		t.Fatalf("ContextReceiver.Greet: got params %v, want [name]", params)
+	}
+}
+
+func TestBoundParamsNoContext(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	sig := funcSignature(t, pkg, "Add")
+	params, hasContext := BoundParams(sig)
+	if hasContext {
+		t.Fatalf("Add: hasContext = true, want false")
+	}
+	if len(params) != 2 {
+		t.Fatalf("Add: got %d bound params, want 2", len(params))
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"fmt"
+	"go/types"
+	"regexp"
+	"strings"
+)
+
+// instantiateDirective is a parsed //gobind:instantiate comment.
+// Gobind otherwise refuses any file containing type parameters; this
+// directive is the proposed opt-in syntax for naming a specific
+// instantiation of a generic function or type that should be bound.
+//
+//	//gobind:instantiate Pair[int32,string] as IntStringPair
+//	//gobind:instantiate NewPair[int32,string] as NewIntStringPair
+//
+// Only directive parsing (parseInstantiateDirective) and constraint
+// checking (CheckInstantiation) exist in this tree. There is no driver
+// that scans a package for these comments, no Go shim generation, and
+// no Java/ObjC class emission.
+type instantiateDirective struct {
+	Generic string   // name of the generic func or type, e.g. "Pair"
+	Args    []string // type arguments, e.g. ["int32", "string"]
+	Alias   string   // bound name, e.g. "IntStringPair"
+}
+
+var instantiateRE = regexp.MustCompile(`^//gobind:instantiate\s+(\w+)\[([^\]]+)\]\s+as\s+(\w+)\s*$`)
+
+// parseInstantiateDirective parses a single comment line, reporting
+// ok == false if line is not a //gobind:instantiate directive.
+func parseInstantiateDirective(line string) (d instantiateDirective, ok bool) {
+	m := instantiateRE.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return instantiateDirective{}, false
+	}
+	args := strings.Split(m[2], ",")
+	for i, a := range args {
+		args[i] = strings.TrimSpace(a)
+	}
+	return instantiateDirective{Generic: m[1], Args: args, Alias: m[3]}, true
+}
+
+// CheckInstantiation verifies that d's type arguments satisfy generic's
+// type parameter constraints (so a Go shim generated for d would be
+// valid Go, if anything in this tree generated one), and reports a
+// diagnostic instead if they don't — for example a type argument that
+// doesn't implement a constraint interface. lookup resolves a type
+// argument's name, as written in the directive, to its types.Type;
+// *Package.LookupType implements it.
+func CheckInstantiation(d instantiateDirective, generic *types.Signature, lookup func(name string) (types.Type, bool)) error {
+	tparams := generic.TypeParams()
+	if tparams == nil || tparams.Len() != len(d.Args) {
+		want := 0
+		if tparams != nil {
+			want = tparams.Len()
+		}
+		return fmt.Errorf("gobind: %s: %s takes %d type argument(s), directive gives %d", d.Alias, d.Generic, want, len(d.Args))
+	}
+	for i := 0; i < tparams.Len(); i++ {
+		argType, ok := lookup(d.Args[i])
+		if !ok {
+			return fmt.Errorf("gobind: %s: unknown type argument %q", d.Alias, d.Args[i])
+		}
+		iface, ok := tparams.At(i).Constraint().Underlying().(*types.Interface)
+		if !ok || iface.Empty() {
+			continue // constraint isn't a plain method interface (e.g. "any", or a union); nothing to check here
+		}
+		if !types.Implements(argType, iface) && !types.Implements(types.NewPointer(argType), iface) {
+			return fmt.Errorf("gobind: %s: %s does not implement %s, required by %s's constraint",
+				d.Alias, d.Args[i], iface, tparams.At(i).Obj().Name())
+		}
+	}
+	return nil
+}
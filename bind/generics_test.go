@@ -0,0 +1,61 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import "testing"
+
+func directiveFor(t *testing.T, ds []instantiateDirective, alias string) instantiateDirective {
+	t.Helper()
+	for _, d := range ds {
+		if d.Alias == alias {
+			return d
+		}
+	}
+	t.Fatalf("no //gobind:instantiate directive found with alias %q", alias)
+	return instantiateDirective{}
+}
+
+func TestInstantiateDirectives(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	ds := pkg.InstantiateDirectives()
+	pair := directiveFor(t, ds, "IntStringPair")
+	if pair.Generic != "Pair" || len(pair.Args) != 2 || pair.Args[0] != "int32" || pair.Args[1] != "string" {
+		t.Fatalf("IntStringPair directive = %+v, want Generic=Pair Args=[int32 string]", pair)
+	}
+
+	newPair := directiveFor(t, ds, "NewIntStringPair")
+	if newPair.Generic != "NewPair" {
+		t.Fatalf("NewIntStringPair directive = %+v, want Generic=NewPair", newPair)
+	}
+
+	dup := directiveFor(t, ds, "DupNumBox")
+	if dup.Generic != "IDupGeneric" || len(dup.Args) != 1 || dup.Args[0] != "NumBox" {
+		t.Fatalf("DupNumBox directive = %+v, want Generic=IDupGeneric Args=[NumBox]", dup)
+	}
+}
+
+func TestCheckInstantiationAcceptsSatisfyingConstraint(t *testing.T) {
+	pkg := loadTestpkg(t)
+	d := directiveFor(t, pkg.InstantiateDirectives(), "DupNumBox")
+
+	sig := funcSignature(t, pkg, "IDupGeneric")
+	if err := CheckInstantiation(d, sig, pkg.LookupType); err != nil {
+		t.Fatalf("CheckInstantiation(%+v) = %v, want nil (NumBox implements Nummer)", d, err)
+	}
+}
+
+func TestCheckInstantiationRejectsUnsatisfyingConstraint(t *testing.T) {
+	pkg := loadTestpkg(t)
+	sig := funcSignature(t, pkg, "IDupGeneric")
+
+	// S does not implement Nummer (it has no Num method), so this
+	// instantiation should be rejected with a diagnostic rather than
+	// gobind emitting a shim that doesn't compile.
+	bad := instantiateDirective{Generic: "IDupGeneric", Args: []string{"S"}, Alias: "DupS"}
+	if err := CheckInstantiation(bad, sig, pkg.LookupType); err == nil {
+		t.Fatalf("CheckInstantiation(%+v) = nil, want an error (S does not implement Nummer)", bad)
+	}
+}
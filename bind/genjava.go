@@ -0,0 +1,56 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import "go/types"
+
+// javaNumericType names the Java type that should represent a Go
+// numeric basic kind with no directly corresponding signed Java
+// primitive: uint, uint32 and uint64 widen to long/BigInteger, and
+// complex64/complex128 would need a small Java value type to hold a
+// real/imaginary pair. Before this, all five kinds were silently
+// skipped by the (nonexistent-in-this-tree) Java generator; see
+// golang.org/x/mobile/bind/testdata/testpkg for the bound Go-side
+// counterparts this is meant to unblock.
+//
+// This is a name classifier only. java.math.BigInteger is a real JDK
+// type, but "go.Seq.Complex64"/"go.Seq.Complex128" are not defined
+// anywhere in this tree — no Complex value type, Java emitter, or JNI
+// marshaling code exists here to back them. A real implementation
+// needs that support type added to the Java runtime half of gobind
+// (not present in this checkout) before these names mean anything.
+func javaNumericType(kind string) (javaType string, ok bool) {
+	switch kind {
+	case "uint", "uint32":
+		return "long", true
+	case "uint64":
+		return "java.math.BigInteger", true
+	case "complex64":
+		return "go.Seq.Complex64", true
+	case "complex128":
+		return "go.Seq.Complex128", true
+	}
+	return "", false
+}
+
+// javaType names the Java type for a bound Go basic type t, including
+// the unsigned and complex kinds javaNumericType classifies.
+func javaType(t *types.Basic) (javaType string, ok bool) {
+	switch t.Kind() {
+	case types.Bool:
+		return "boolean", true
+	case types.Int, types.Int32:
+		return "int", true
+	case types.Int64:
+		return "long", true
+	case types.Float32:
+		return "float", true
+	case types.Float64:
+		return "double", true
+	case types.String:
+		return "String", true
+	}
+	return javaNumericType(t.Name())
+}
@@ -0,0 +1,60 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"go/types"
+	"testing"
+)
+
+func varType(t *testing.T, pkg *Package, name string) *types.Basic {
+	t.Helper()
+	for ident, obj := range pkg.Info.Defs {
+		if ident.Name != name {
+			continue
+		}
+		if v, ok := obj.(*types.Var); ok {
+			if b, ok := v.Type().(*types.Basic); ok {
+				return b
+			}
+		}
+	}
+	t.Fatalf("basic-typed var %s not found in %s", name, testpkgPath)
+	return nil
+}
+
+func TestJavaTypeNumeric(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Uint", "long"},
+		{"Uint32", "long"},
+		{"Uint64", "java.math.BigInteger"},
+		{"C64", "go.Seq.Complex64"},
+		{"C128", "go.Seq.Complex128"},
+	}
+	for _, tc := range tests {
+		got, ok := javaType(varType(t, pkg, tc.name))
+		if !ok {
+			t.Errorf("javaType(%s): ok = false, want true", tc.name)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("javaType(%s) = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestJavaTypeSigned(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	got, ok := javaType(varType(t, pkg, "IntVar"))
+	if !ok || got != "int" {
+		t.Errorf("javaType(IntVar) = (%s, %v), want (int, true)", got, ok)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import "go/types"
+
+// objcNumericType is genjava.go's javaNumericType for Objective-C:
+// uint/uint32/uint64 map onto the nearest-width unsigned C integer
+// types, and the complex kinds would need a small ObjC struct to hold
+// a real/imaginary pair.
+//
+// This is a name classifier only. NSUInteger, uint32_t and "unsigned
+// long long" are real C/Foundation types, but "GoSeqComplex64"/
+// "GoSeqComplex128" are not defined anywhere in this tree — no such
+// struct, ObjC emitter, or cgo marshaling code exists here. A real
+// implementation needs that support type added to the ObjC runtime
+// half of gobind (not present in this checkout) before these names
+// mean anything.
+func objcNumericType(kind string) (objcType string, ok bool) {
+	switch kind {
+	case "uint":
+		return "NSUInteger", true
+	case "uint32":
+		return "uint32_t", true
+	case "uint64":
+		return "unsigned long long", true
+	case "complex64":
+		return "GoSeqComplex64", true
+	case "complex128":
+		return "GoSeqComplex128", true
+	}
+	return "", false
+}
+
+// objcType names the Objective-C type for a bound Go basic type t,
+// including the unsigned and complex kinds objcNumericType classifies.
+func objcType(t *types.Basic) (objcType string, ok bool) {
+	switch t.Kind() {
+	case types.Bool:
+		return "BOOL", true
+	case types.Int, types.Int32:
+		return "int32_t", true
+	case types.Int64:
+		return "int64_t", true
+	case types.Float32:
+		return "float", true
+	case types.Float64:
+		return "double", true
+	case types.String:
+		return "NSString*", true
+	}
+	return objcNumericType(t.Name())
+}
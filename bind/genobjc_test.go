@@ -0,0 +1,32 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import "testing"
+
+func TestObjcTypeNumeric(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Uint", "NSUInteger"},
+		{"Uint32", "uint32_t"},
+		{"Uint64", "unsigned long long"},
+		{"C64", "GoSeqComplex64"},
+		{"C128", "GoSeqComplex128"},
+	}
+	for _, tc := range tests {
+		got, ok := objcType(varType(t, pkg, tc.name))
+		if !ok {
+			t.Errorf("objcType(%s): ok = false, want true", tc.name)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("objcType(%s) = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+)
+
+// Package is a partially type-checked Go package: enough to classify
+// individual declarations for binding, even when some of its imports
+// (commonly a sibling testdata package, or asset) can't be resolved in
+// the environment doing the classifying. Declarations that don't
+// depend on an unresolved import still get full type information.
+type Package struct {
+	Fset *token.FileSet
+	File *ast.File
+	Info *types.Info
+	Pkg  *types.Package
+}
+
+// LoadFile type-checks a single Go source file, tolerating import
+// errors rather than aborting on the first one.
+func LoadFile(fset *token.FileSet, f *ast.File, pkgName string) *Package {
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{
+		Importer: importer.Default(),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(pkgName, fset, []*ast.File{f}, info)
+	return &Package{Fset: fset, File: f, Info: info, Pkg: pkg}
+}
+
+// basicTypeByName resolves the Go predeclared basic type names that can
+// appear as a //gobind:instantiate type argument (e.g. "int32",
+// "string") to their types.Type.
+var basicTypeByName = func() map[string]types.Type {
+	m := make(map[string]types.Type)
+	for _, t := range types.Typ {
+		m[t.Name()] = t
+	}
+	return m
+}()
+
+// LookupType resolves name, as written in a //gobind:instantiate
+// directive's type argument list, to a types.Type: either a
+// predeclared basic type or a type declared in p.
+func (p *Package) LookupType(name string) (types.Type, bool) {
+	if t, ok := basicTypeByName[name]; ok {
+		return t, true
+	}
+	if p.Pkg == nil {
+		return nil, false
+	}
+	obj := p.Pkg.Scope().Lookup(name)
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+	return tn.Type(), true
+}
+
+// Func looks up the top-level function, or method with the given
+// receiver-less name, declared by name. It returns nil if name wasn't
+// declared, or if type-checking couldn't recover its signature (for
+// example because it mentions a type from an unresolved import).
+func (p *Package) Func(name string) *types.Func {
+	for ident, obj := range p.Info.Defs {
+		if ident.Name != name {
+			continue
+		}
+		if fn, ok := obj.(*types.Func); ok {
+			return fn
+		}
+	}
+	return nil
+}
+
+// InstantiateDirectives returns every //gobind:instantiate directive
+// found in the file, in source order.
+func (p *Package) InstantiateDirectives() []instantiateDirective {
+	var out []instantiateDirective
+	for _, cg := range p.File.Comments {
+		for _, c := range cg.List {
+			if d, ok := parseInstantiateDirective(c.Text); ok {
+				out = append(out, d)
+			}
+		}
+	}
+	return out
+}
@@ -44,16 +44,8 @@ type I interface {
 	Result() interface{}
 }
 
-var (
-	Uint   uint
-	Uint32 uint32
-	Uint64 uint64
-	C64    complex64  = 0
-	C128   complex128 = 0
-)
-
-const (
-	Cuint   uint   = 0
-	Cuint32 uint32 = 0
-	Cuint64 uint64 = 0
-)
+// UnsupportedVariadic has a variadic parameter whose element type
+// (chan int) gobind doesn't know how to marshal, so the function is
+// rejected rather than bound.
+func UnsupportedVariadic(x int, rest ...chan int) {
+}
@@ -42,6 +42,10 @@ const (
 	SmallestNonzeroFloat32 float32 = math.SmallestNonzeroFloat64
 	MaxFloat32             float32 = math.MaxFloat32
 	Log2E                          = math.Log2E
+
+	Cuint   uint   = 0
+	Cuint32 uint32 = 0
+	Cuint64 uint64 = 0
 )
 
 var (
@@ -51,6 +55,12 @@ var (
 	InterfaceVar  I
 	InterfaceVar2 I2
 	NodeVar       = &Node{V: "a struct var"}
+
+	Uint   uint
+	Uint32 uint32
+	Uint64 uint64
+	C64    complex64  = 1 + 2i
+	C128   complex128 = 3 + 4i
 )
 
 type Nummer interface {
@@ -144,6 +154,68 @@ func NumSCollected() int {
 	return numSCollected
 }
 
+func AddUint32(x, y uint32) uint32 {
+	return x + y
+}
+
+func AddUint64(x, y uint64) uint64 {
+	return x + y
+}
+
+func AddComplex64(x, y complex64) complex64 {
+	return x + y
+}
+
+func AddComplex128(x, y complex128) complex128 {
+	return x + y
+}
+
+// Sprintf is variadic, with an empty-interface element type;
+// bind.BindVariadic classifies it as acceptable. See bind/variadic.go
+// for what is and isn't implemented around that classification.
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(format, args...)
+}
+
+// Pair is a generic struct with a method, analogous to S2 and its Sum
+// method, but bound only for the instantiation named by the
+// //gobind:instantiate directive below rather than directly.
+type Pair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("%v=%v", p.Key, p.Val)
+}
+
+// NewPair is a generic function; like Pair, it is bound only for the
+// instantiation named below.
+func NewPair[K comparable, V any](k K, v V) *Pair[K, V] {
+	return &Pair[K, V]{Key: k, Val: v}
+}
+
+//gobind:instantiate Pair[int32,string] as IntStringPair
+//gobind:instantiate NewPair[int32,string] as NewIntStringPair
+
+// NumBox is a minimal Nummer, used only to instantiate IDupGeneric
+// below with a constraint-satisfying type argument.
+type NumBox struct {
+	N int32
+}
+
+func (NumBox) Num() {}
+
+// IDupGeneric is a generic analogue of IDup: its type parameter is
+// constrained to Nummer rather than unconstrained, so the instantiation
+// below must be checked against that constraint the same way a
+// non-generic bound interface parameter would be.
+func IDupGeneric[T Nummer](v T) T {
+	return v
+}
+
+//gobind:instantiate IDupGeneric[NumBox] as DupNumBox
+
 func I2Dup(i I2) I2 {
 	return i
 }
@@ -612,11 +684,44 @@ type Testpkg interface{}
 func ClashingParameterFromOtherPackage(_ *secondpkg.Secondpkg) {}
 
 type MyStruct struct {
+	cancelled bool
 }
 
-// Test that constructors with incompatible signatures are ignored.
+// NewMyStruct has a context.Context first parameter. bind.BoundParams
+// classifies it as a leading context parameter to be dropped from the
+// bound signature; see bind/context.go for what is and isn't
+// implemented around that classification.
 func NewMyStruct(ctx context.Context) *MyStruct {
-	return nil
+	return &MyStruct{cancelled: ctx.Err() != nil}
+}
+
+// Cancelled reports whether ctx was cancelled before NewMyStruct returned.
+func (m *MyStruct) Cancelled() bool {
+	return m.cancelled
+}
+
+// Delay blocks until ctx is done or d elapses, also exercising a bound
+// method (as opposed to a constructor) that takes a context.
+func (m *MyStruct) Delay(ctx context.Context, d int64) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Duration(d) * time.Millisecond):
+		return nil
+	}
+}
+
+// ContextReceiver is an interface with a context-taking method, so
+// BoundParams's classification of a leading context.Context can be
+// exercised on an interface method signature too, not just a
+// top-level function.
+type ContextReceiver interface {
+	Greet(ctx context.Context, name string) string
+}
+
+// CallWithContext calls r.Greet with ctx.
+func CallWithContext(ctx context.Context, r ContextReceiver) string {
+	return r.Greet(ctx, "context")
 }
 
 type Int32Constructed struct{}
@@ -0,0 +1,61 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// variadicElem reports the element type of sig's trailing ...T
+// parameter, and whether T is one gobind would be able to marshal on
+// its own (a bindable basic kind) or as the empty interface (whose
+// elements would be boxed and marshaled individually, one at a time).
+//
+// This only classifies the element type. It does not generate a Java
+// varargs method, an ObjC NSArray<T*>* parameter, or any marshaling
+// code — none of that exists in this tree.
+func variadicElem(sig *types.Signature) (elem types.Type, ok bool) {
+	if !sig.Variadic() {
+		return nil, false
+	}
+	params := sig.Params()
+	slice, ok := params.At(params.Len() - 1).Type().(*types.Slice)
+	if !ok {
+		return nil, false
+	}
+	elem = slice.Elem()
+	if types.IsInterface(elem) {
+		return elem, true
+	}
+	if basic, ok := elem.(*types.Basic); ok {
+		switch basic.Kind() {
+		case types.Bool, types.Int, types.Int32, types.Int64,
+			types.Uint, types.Uint32, types.Uint64,
+			types.Float32, types.Float64, types.String,
+			types.Complex64, types.Complex128:
+			return elem, true
+		}
+	}
+	return elem, false
+}
+
+// BindVariadic reports whether gobind would accept name's trailing
+// ...T parameter, returning T's element type. If T isn't a type
+// variadicElem accepts, BindVariadic returns a diagnostic identifying
+// the offending element type, rather than the generator silently
+// dropping the function the way testdata/ignore.go documents for other
+// unsupported signatures. It does not itself emit a Java varargs
+// method or ObjC NSArray<T*>* marshaling code.
+func BindVariadic(name string, sig *types.Signature) (elem types.Type, err error) {
+	if !sig.Variadic() {
+		return nil, fmt.Errorf("gobind: %s: not a variadic function", name)
+	}
+	elem, ok := variadicElem(sig)
+	if !ok {
+		return nil, fmt.Errorf("gobind: %s: unsupported variadic element type %s", name, elem)
+	}
+	return elem, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bind
+
+import (
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestBindVariadicInterfaceElem(t *testing.T) {
+	pkg := loadTestpkg(t)
+
+	sig := funcSignature(t, pkg, "Sprintf")
+	elem, err := BindVariadic("Sprintf", sig)
+	if err != nil {
+		t.Fatalf("BindVariadic(Sprintf) error: %v", err)
+	}
+	if !types.IsInterface(elem) {
+		t.Fatalf("Sprintf: elem = %v, want interface{}", elem)
+	}
+}
+
+func TestBindVariadicRejectsUnsupportedElem(t *testing.T) {
+	const ignorePath = "testdata/ignore.go"
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, ignorePath, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("ParseFile(%s): %v", ignorePath, err)
+	}
+	pkg := LoadFile(fset, f, "ignore")
+
+	sig := funcSignature(t, pkg, "UnsupportedVariadic")
+	if _, err := BindVariadic("UnsupportedVariadic", sig); err == nil {
+		t.Fatal("BindVariadic(UnsupportedVariadic) = nil error, want a diagnostic rejecting the chan int element")
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package font provides the default UI fonts for apps.
+package font
+
+import "sync"
+
+var (
+	defaultOnce sync.Once
+	defaultTTF  []byte
+	defaultErr  error
+
+	monospaceOnce sync.Once
+	monospaceTTF  []byte
+	monospaceErr  error
+)
+
+// Default returns the TTF data for the default UI font.
+func Default() ([]byte, error) {
+	defaultOnce.Do(func() {
+		defaultTTF, defaultErr = buildDefault()
+	})
+	return defaultTTF, defaultErr
+}
+
+// Monospace returns the TTF data for the default monospace font.
+func Monospace() ([]byte, error) {
+	monospaceOnce.Do(func() {
+		monospaceTTF, monospaceErr = buildMonospace()
+	})
+	return monospaceTTF, monospaceErr
+}
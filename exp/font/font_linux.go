@@ -6,26 +6,135 @@
 
 package font
 
-import "os"
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mobile/exp/font/internal/fallback"
+)
+
+// defaultPath and monospacePath record where the font last returned by
+// buildDefault/buildMonospace came from, for DefaultPath and
+// MonospacePath. They are left empty when the embedded fallback font
+// was used instead of a file on disk.
+var (
+	defaultPath   string
+	monospacePath string
+)
+
+// DefaultPath returns the filesystem path of the font in use for
+// Default, or "" if no system font could be found and the embedded
+// fallback is in use.
+func DefaultPath() string { return defaultPath }
+
+// MonospacePath is DefaultPath for the font in use for Monospace.
+func MonospacePath() string { return monospacePath }
+
+// sansNames and monoNames are the file names looked for, in order, when
+// walking fontDirs. They cover the Noto and Droid packages most distros
+// ship, plus DejaVu as a last resort before falling back to the
+// embedded font.
+var (
+	sansNames = []string{"NotoSans-Regular.ttf", "DroidSans.ttf", "DejaVuSans.ttf"}
+	monoNames = []string{"NotoSansMono-Regular.ttf", "NotoMono-Regular.ttf", "DroidSansMono.ttf", "DejaVuSansMono.ttf"}
+)
 
 func buildDefault() ([]byte, error) {
-	// Try Noto first, but fall back to Droid as the latter was deprecated
-	noto, nerr := os.ReadFile("/usr/share/fonts/truetype/noto/NotoSans-Regular.ttf")
-	if nerr != nil {
-		if droid, err := os.ReadFile("/usr/share/fonts/truetype/droid/DroidSans.ttf"); err == nil {
-			return droid, nil
+	return resolveFont(&defaultPath, "sans-serif", sansNames, fallback.Sans)
+}
+
+func buildMonospace() ([]byte, error) {
+	return resolveFont(&monospacePath, "monospace", monoNames, fallback.Mono)
+}
+
+// resolveFont finds a font for the fontconfig generic family name (such
+// as "sans-serif" or "monospace"), trying in order: fc-match, a walk of
+// well-known font directories, and finally embedded, a fallback that
+// never fails. *path is set to the resolved on-disk location, or left
+// empty if embedded was used.
+//
+// resolveFont never returns an error: a missing or broken fontconfig
+// install and an unrecognized distro layout are both expected
+// conditions on a bare container image, not failures worth surfacing to
+// app authors who just want a font to draw text with.
+func resolveFont(path *string, family string, names []string, embedded []byte) ([]byte, error) {
+	if p, err := fcMatch(family); err == nil {
+		if b, err := os.ReadFile(p); err == nil {
+			*path = p
+			return b, nil
+		}
+	}
+
+	if p := findInDirs(fontDirs(), names); p != "" {
+		if b, err := os.ReadFile(p); err == nil {
+			*path = p
+			return b, nil
 		}
 	}
-	return noto, nerr
+
+	*path = ""
+	return embedded, nil
 }
 
-func buildMonospace() ([]byte, error) {
-	// Try Noto first, but fall back to Droid as the latter was deprecated
-	noto, nerr := os.ReadFile("/usr/share/fonts/truetype/noto/NotoMono-Regular.ttf")
-	if nerr != nil {
-		if droid, err := os.ReadFile("/usr/share/fonts/truetype/droid/DroidSansMono.ttf"); err == nil {
-			return droid, nil
+// fcMatch shells out to fontconfig's fc-match, which already knows how
+// to apply the user's and distro's fontconfig configuration, including
+// $FONTCONFIG_PATH. It reports an error if fc-match isn't on $PATH or
+// fails to resolve family to a file.
+func fcMatch(family string) (string, error) {
+	fc, err := exec.LookPath("fc-match")
+	if err != nil {
+		return "", err
+	}
+	out, err := exec.Command(fc, "--format=%{file}", family).Output()
+	if err != nil {
+		return "", err
+	}
+	path := strings.TrimSpace(string(out))
+	if path == "" {
+		return "", os.ErrNotExist
+	}
+	return path, nil
+}
+
+// fontDirs returns the directories to search for a font file when
+// fontconfig is unavailable, most specific first: anything named by
+// $FONTCONFIG_PATH or $XDG_DATA_DIRS, then a hard-coded list covering
+// the Debian/Ubuntu, Fedora, Arch and Alpine package layouts.
+func fontDirs() []string {
+	var dirs []string
+	if p := os.Getenv("FONTCONFIG_PATH"); p != "" {
+		dirs = append(dirs, filepath.SplitList(p)...)
+	}
+	if p := os.Getenv("XDG_DATA_DIRS"); p != "" {
+		for _, d := range filepath.SplitList(p) {
+			dirs = append(dirs, filepath.Join(d, "fonts"))
+		}
+	}
+	return append(dirs,
+		"/usr/share/fonts/truetype/noto",  // Debian, Ubuntu
+		"/usr/share/fonts/truetype/droid", // Debian, Ubuntu
+		"/usr/share/fonts/truetype/dejavu",
+		"/usr/share/fonts/noto",  // Fedora
+		"/usr/share/fonts/droid", // Fedora
+		"/usr/share/fonts/dejavu",
+		"/usr/share/fonts/TTF", // Arch
+		"/usr/share/fonts",     // Alpine, and anything else that flattens the tree
+		"/usr/local/share/fonts",
+	)
+}
+
+// findInDirs returns the first dir/name that exists, trying every name
+// in every directory before giving up.
+func findInDirs(dirs, names []string) string {
+	for _, dir := range dirs {
+		for _, name := range names {
+			p := filepath.Join(dir, name)
+			if _, err := os.Stat(p); err == nil {
+				return p
+			}
 		}
 	}
-	return noto, nerr
+	return ""
 }
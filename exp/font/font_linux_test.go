@@ -0,0 +1,64 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !android
+
+package font
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindInDirsReturnsFirstMatch(t *testing.T) {
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	want := filepath.Join(dir2, "Second.ttf")
+	if err := os.WriteFile(want, []byte("fake ttf"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := findInDirs([]string{dir1, dir2}, []string{"First.ttf", "Second.ttf"})
+	if got != want {
+		t.Errorf("findInDirs = %q, want %q", got, want)
+	}
+}
+
+func TestFindInDirsNoMatch(t *testing.T) {
+	dir := t.TempDir()
+	if got := findInDirs([]string{dir}, []string{"NoSuchFont.ttf"}); got != "" {
+		t.Errorf("findInDirs = %q, want \"\"", got)
+	}
+}
+
+func TestFontDirsHonorsEnv(t *testing.T) {
+	t.Setenv("FONTCONFIG_PATH", "/fc/one"+string(os.PathListSeparator)+"/fc/two")
+	t.Setenv("XDG_DATA_DIRS", "/xdg/one"+string(os.PathListSeparator)+"/xdg/two")
+
+	dirs := fontDirs()
+
+	want := []string{"/fc/one", "/fc/two", filepath.Join("/xdg/one", "fonts"), filepath.Join("/xdg/two", "fonts")}
+	if len(dirs) < len(want) {
+		t.Fatalf("fontDirs() = %v, want it to start with %v", dirs, want)
+	}
+	for i, w := range want {
+		if dirs[i] != w {
+			t.Errorf("fontDirs()[%d] = %q, want %q", i, dirs[i], w)
+		}
+	}
+}
+
+func TestFontDirsWithoutEnv(t *testing.T) {
+	t.Setenv("FONTCONFIG_PATH", "")
+	t.Setenv("XDG_DATA_DIRS", "")
+
+	dirs := fontDirs()
+	if len(dirs) == 0 {
+		t.Fatal("fontDirs() = [], want the hard-coded distro fallback list")
+	}
+	if dirs[0] != "/usr/share/fonts/truetype/noto" {
+		t.Errorf("fontDirs()[0] = %q, want the Debian/Ubuntu Noto path first", dirs[0])
+	}
+}
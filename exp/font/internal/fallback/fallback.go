@@ -0,0 +1,18 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fallback embeds the DejaVu Sans and DejaVu Sans Mono TrueType
+// fonts, used as a last resort by package font when no system font can
+// be located, such as on a bare container image with no fontconfig and
+// no /usr/share/fonts. See LICENSE in this directory for the fonts'
+// license.
+package fallback
+
+import _ "embed"
+
+//go:embed DejaVuSans.ttf
+var Sans []byte
+
+//go:embed DejaVuSansMono.ttf
+var Mono []byte